@@ -0,0 +1,74 @@
+// Package devices provides pluggable abstractions over the CPU, memory,
+// root disk usage, host and temperature metrics peeker displays, so the
+// StatusBar builders for those never talk to gopsutil (or any other backend)
+// directly. Partition enumeration, the history samplers and the network
+// sampler are not covered and call gopsutil directly.
+package devices
+
+import (
+	"github.com/shirou/gopsutil/v4/cpu"
+	"github.com/shirou/gopsutil/v4/disk"
+	"github.com/shirou/gopsutil/v4/host"
+	"github.com/shirou/gopsutil/v4/mem"
+	"github.com/shirou/gopsutil/v4/sensors"
+)
+
+// CPUProvider reports CPU identification and usage.
+type CPUProvider interface {
+	Info() ([]cpu.InfoStat, error)
+	Percent() ([]float64, error)
+}
+
+// MemProvider reports virtual memory usage.
+type MemProvider interface {
+	VirtualMemory() (*mem.VirtualMemoryStat, error)
+}
+
+// DiskProvider reports file system usage.
+type DiskProvider interface {
+	Usage(path string) (*disk.UsageStat, error)
+}
+
+// HostProvider reports information about the host itself.
+type HostProvider interface {
+	Info() (*host.InfoStat, error)
+}
+
+// TempProvider reports sensor temperatures. The implementation is selected
+// per-OS at build time; see devices_linux.go, devices_darwin.go,
+// devices_freebsd.go, devices_aix.go and devices_windows.go.
+type TempProvider interface {
+	Temperatures() ([]sensors.TemperatureStat, error)
+}
+
+// gopsutilCPU, gopsutilMem, gopsutilDisk and gopsutilHost are the default
+// providers, backed directly by gopsutil. Unlike temperature sensing, they
+// behave the same on every OS gopsutil supports, so they aren't split
+// per-OS.
+type gopsutilCPU struct{}
+
+func (gopsutilCPU) Info() ([]cpu.InfoStat, error) { return cpu.Info() }
+func (gopsutilCPU) Percent() ([]float64, error)   { return cpu.Percent(0, true) }
+
+type gopsutilMem struct{}
+
+func (gopsutilMem) VirtualMemory() (*mem.VirtualMemoryStat, error) { return mem.VirtualMemory() }
+
+type gopsutilDisk struct{}
+
+func (gopsutilDisk) Usage(path string) (*disk.UsageStat, error) { return disk.Usage(path) }
+
+type gopsutilHost struct{}
+
+func (gopsutilHost) Info() (*host.InfoStat, error) { return host.Info() }
+
+// CPU, Mem, Disk and Host are the providers used unless overridden, e.g. by
+// tests substituting a fake. Temp is registered by whichever devices_*.go
+// file matches the build's GOOS.
+var (
+	CPU  CPUProvider  = gopsutilCPU{}
+	Mem  MemProvider  = gopsutilMem{}
+	Disk DiskProvider = gopsutilDisk{}
+	Host HostProvider = gopsutilHost{}
+	Temp TempProvider
+)
@@ -0,0 +1,18 @@
+//go:build aix
+
+package devices
+
+import "github.com/shirou/gopsutil/v4/sensors"
+
+func init() {
+	Temp = aixTemp{}
+}
+
+// aixTemp reports sensor temperatures on AIX. gopsutil has no AIX sensor
+// backend yet, so Temperatures currently just surfaces its not-implemented
+// error; the seam exists so a real backend can be dropped in later.
+type aixTemp struct{}
+
+func (aixTemp) Temperatures() ([]sensors.TemperatureStat, error) {
+	return sensors.SensorsTemperatures()
+}
@@ -0,0 +1,19 @@
+//go:build darwin
+
+package devices
+
+import "github.com/shirou/gopsutil/v4/sensors"
+
+func init() {
+	Temp = darwinTemp{}
+}
+
+// darwinTemp reports sensor temperatures on Darwin. For now it just defers
+// to gopsutil's unified sensors.SensorsTemperatures, which reads the SMC
+// under the hood; this is the seam a dedicated SMC binding would replace
+// later without touching the render code.
+type darwinTemp struct{}
+
+func (darwinTemp) Temperatures() ([]sensors.TemperatureStat, error) {
+	return sensors.SensorsTemperatures()
+}
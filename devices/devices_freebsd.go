@@ -0,0 +1,20 @@
+//go:build freebsd
+
+package devices
+
+import "github.com/shirou/gopsutil/v4/sensors"
+
+func init() {
+	Temp = freebsdTemp{}
+}
+
+// freebsdTemp reports sensor temperatures on FreeBSD. For now it just
+// defers to gopsutil's unified sensors.SensorsTemperatures, which reads
+// sysctl (dev.cpu.N.temperature) under the hood; this is the seam a
+// dedicated sysctl binding would replace later without touching the render
+// code.
+type freebsdTemp struct{}
+
+func (freebsdTemp) Temperatures() ([]sensors.TemperatureStat, error) {
+	return sensors.SensorsTemperatures()
+}
@@ -0,0 +1,19 @@
+//go:build linux
+
+package devices
+
+import "github.com/shirou/gopsutil/v4/sensors"
+
+func init() {
+	Temp = linuxTemp{}
+}
+
+// linuxTemp reports sensor temperatures on Linux. For now it just defers to
+// gopsutil's unified sensors.SensorsTemperatures, which is itself backed by
+// /sys/class/thermal here; this is the seam a Linux-specific backend would
+// replace later without touching the render code.
+type linuxTemp struct{}
+
+func (linuxTemp) Temperatures() ([]sensors.TemperatureStat, error) {
+	return sensors.SensorsTemperatures()
+}
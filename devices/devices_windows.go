@@ -0,0 +1,19 @@
+//go:build windows
+
+package devices
+
+import "github.com/shirou/gopsutil/v4/sensors"
+
+func init() {
+	Temp = windowsTemp{}
+}
+
+// windowsTemp reports sensor temperatures on Windows. For now it just
+// defers to gopsutil's unified sensors.SensorsTemperatures, which is backed
+// by WMI here; this is the seam a dedicated WMI binding would replace later
+// without touching the render code.
+type windowsTemp struct{}
+
+func (windowsTemp) Temperatures() ([]sensors.TemperatureStat, error) {
+	return sensors.SensorsTemperatures()
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Morfo-si/peeker/devices"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/shirou/gopsutil/v4/disk"
+)
+
+// pseudoFilesystems are excluded from WithAllDisks since they don't
+// represent real, user-relevant storage.
+var pseudoFilesystems = map[string]bool{
+	"tmpfs":    true,
+	"devfs":    true,
+	"overlay":  true,
+	"squashfs": true,
+}
+
+// WithAllDisks populates usage for every real mountpoint, for use by
+// DisplayAllDisks instead of the single "/" shown by WithDiskInformation.
+func (sb *StatusBar) WithAllDisks() *StatusBar {
+	partitions, err := disk.Partitions(false)
+	if err != nil {
+		return sb
+	}
+
+	var usages []*disk.UsageStat
+	for _, p := range partitions {
+		if pseudoFilesystems[p.Fstype] {
+			continue
+		}
+		usage, err := devices.Disk.Usage(p.Mountpoint)
+		if err != nil {
+			continue
+		}
+		usages = append(usages, usage)
+	}
+	sb.disks = usages
+	return sb
+}
+
+// DisplayAllDisks renders one row per disk usage collected by WithAllDisks.
+// When the terminal is too narrow to fit a row per mountpoint, it collapses
+// to a single combined summary line.
+func DisplayAllDisks(sb StatusBar, width int) string {
+	if len(sb.disks) == 0 {
+		return ""
+	}
+
+	rows := make([]string, 0, len(sb.disks))
+	for _, u := range sb.disks {
+		rows = append(rows, fmt.Sprintf("%s: %d/%d GB (%2.f%%)",
+			u.Path, u.Used/gigabyteDiv, u.Total/gigabyteDiv, u.UsedPercent))
+	}
+	line := strings.Join(rows, "\n")
+	if lipgloss.Width(line) > width {
+		line = summarizeDisks(sb.disks)
+	}
+
+	return diskStyle.Width(width).Align(lipgloss.Left).Render(line)
+}
+
+// summarizeDisks combines every usage entry into a single aggregate line.
+func summarizeDisks(usages []*disk.UsageStat) string {
+	var totalUsed, totalSize uint64
+	for _, u := range usages {
+		totalUsed += u.Used
+		totalSize += u.Total
+	}
+
+	var percent float64
+	if totalSize > 0 {
+		percent = float64(totalUsed) / float64(totalSize) * 100
+	}
+
+	return fmt.Sprintf("Disks: %d/%d GB (%2.f%%)", totalUsed/gigabyteDiv, totalSize/gigabyteDiv, percent)
+}
@@ -0,0 +1,26 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/shirou/gopsutil/v4/disk"
+)
+
+func TestSummarizeDisks(t *testing.T) {
+	usages := []*disk.UsageStat{
+		{Used: 1 * gigabyteDiv, Total: 4 * gigabyteDiv},
+		{Used: 3 * gigabyteDiv, Total: 6 * gigabyteDiv},
+	}
+
+	got := summarizeDisks(usages)
+	want := "Disks: 4/10 GB (40%)"
+	if got != want {
+		t.Errorf("summarizeDisks(...) = %q, want %q", got, want)
+	}
+}
+
+func TestSummarizeDisksEmpty(t *testing.T) {
+	if got := summarizeDisks(nil); got != "Disks: 0/0 GB ( 0%)" {
+		t.Errorf("summarizeDisks(nil) = %q, want \"Disks: 0/0 GB ( 0%%)\"", got)
+	}
+}
@@ -0,0 +1,156 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// Format selects the output encoding used by StatusBar.Encode.
+type Format string
+
+const (
+	FormatText       Format = "text"
+	FormatJSON       Format = "json"
+	FormatPrometheus Format = "prometheus"
+	FormatInflux     Format = "influx"
+)
+
+// ParseFormat validates a --format flag value.
+func ParseFormat(s string) (Format, error) {
+	switch Format(s) {
+	case FormatText, FormatJSON, FormatPrometheus, FormatInflux:
+		return Format(s), nil
+	default:
+		return "", fmt.Errorf("unknown format %q", s)
+	}
+}
+
+// snapshotJSON is the shape written by Encode in FormatJSON, and the
+// intermediate representation used to build the other structured formats.
+type snapshotJSON struct {
+	Host struct {
+		Name string `json:"name"`
+		Arch string `json:"arch"`
+	} `json:"host"`
+	CPU struct {
+		Model   string  `json:"model"`
+		MHz     float64 `json:"mhz"`
+		Percent float64 `json:"percent"`
+	} `json:"cpu"`
+	Memory struct {
+		TotalBytes  uint64  `json:"total_bytes"`
+		UsedBytes   uint64  `json:"used_bytes"`
+		UsedPercent float64 `json:"used_percent"`
+	} `json:"memory"`
+	Disk struct {
+		Mountpoint  string  `json:"mountpoint"`
+		TotalBytes  uint64  `json:"total_bytes"`
+		UsedBytes   uint64  `json:"used_bytes"`
+		UsedPercent float64 `json:"used_percent"`
+	} `json:"disk"`
+}
+
+// toJSON collects the StatusBar's samples into snapshotJSON.
+func (sb StatusBar) toJSON() snapshotJSON {
+	var s snapshotJSON
+
+	if sb.host != nil {
+		s.Host.Name = sb.host.Hostname
+		s.Host.Arch = sb.host.KernelArch
+	}
+	if len(sb.cpu) > 0 {
+		s.CPU.Model = sb.cpu[0].ModelName
+		s.CPU.MHz = sb.cpu[0].Mhz
+	}
+	s.CPU.Percent = sb.cpuPercent
+	if sb.mem != nil {
+		s.Memory.TotalBytes = sb.mem.Total
+		s.Memory.UsedBytes = sb.mem.Used
+		s.Memory.UsedPercent = sb.mem.UsedPercent
+	}
+	if sb.disk != nil {
+		s.Disk.Mountpoint = sb.disk.Path
+		s.Disk.TotalBytes = sb.disk.Total
+		s.Disk.UsedBytes = sb.disk.Used
+		s.Disk.UsedPercent = sb.disk.UsedPercent
+	}
+
+	return s
+}
+
+// Encode writes the StatusBar's current samples to w in the given Format.
+// FormatText reuses the existing lipgloss layout; the other formats are
+// meant for scraping or ingestion rather than a terminal.
+func (sb StatusBar) Encode(w io.Writer, format Format) error {
+	switch format {
+	case FormatText:
+		_, err := fmt.Fprintln(w, sb.layout())
+		return err
+	case FormatJSON:
+		return json.NewEncoder(w).Encode(sb.toJSON())
+	case FormatPrometheus:
+		return sb.encodePrometheus(w)
+	case FormatInflux:
+		return sb.encodeInflux(w)
+	default:
+		return fmt.Errorf("unknown format %q", format)
+	}
+}
+
+// encodePrometheus writes the snapshot in Prometheus text exposition
+// format.
+func (sb StatusBar) encodePrometheus(w io.Writer) error {
+	j := sb.toJSON()
+	_, err := fmt.Fprintf(w,
+		"# HELP peeker_cpu_percent Percentage of CPU in use.\n"+
+			"# TYPE peeker_cpu_percent gauge\n"+
+			"peeker_cpu_percent %.2f\n"+
+			"# HELP peeker_mem_used_bytes Memory in use.\n"+
+			"# TYPE peeker_mem_used_bytes gauge\n"+
+			"peeker_mem_used_bytes{state=\"used\"} %d\n"+
+			"# HELP peeker_mem_total_bytes Total memory installed.\n"+
+			"# TYPE peeker_mem_total_bytes gauge\n"+
+			"peeker_mem_total_bytes %d\n"+
+			"# HELP peeker_disk_used_bytes Disk space in use, by mount.\n"+
+			"# TYPE peeker_disk_used_bytes gauge\n"+
+			"peeker_disk_used_bytes{mount=%q} %d\n",
+		j.CPU.Percent,
+		j.Memory.UsedBytes,
+		j.Memory.TotalBytes,
+		j.Disk.Mountpoint, j.Disk.UsedBytes,
+	)
+	return err
+}
+
+// encodeInflux writes the snapshot as a single InfluxDB line protocol point.
+func (sb StatusBar) encodeInflux(w io.Writer) error {
+	j := sb.toJSON()
+	_, err := fmt.Fprintf(w,
+		"peeker,host=%s cpu_percent=%.2f,mem_used_bytes=%di,mem_total_bytes=%di,disk_used_bytes=%di,disk_total_bytes=%di\n",
+		j.Host.Name, j.CPU.Percent, j.Memory.UsedBytes, j.Memory.TotalBytes, j.Disk.UsedBytes, j.Disk.TotalBytes,
+	)
+	return err
+}
+
+// Serve exposes a freshly-sampled StatusBar in Prometheus format at
+// addr+"/metrics" on every scrape, so peeker can run like a mini
+// node_exporter.
+func Serve(addr string) error {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/metrics", func(w http.ResponseWriter, r *http.Request) {
+		bar := NewStatusBar().
+			WithHostInformation().
+			WithCPUInformation().
+			WithMemoryInformation().
+			WithDiskInformation()
+
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		if err := bar.Encode(w, FormatPrometheus); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+
+	return http.ListenAndServe(addr, mux)
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/shirou/gopsutil/v4/disk"
+	"github.com/shirou/gopsutil/v4/mem"
+)
+
+func TestParseFormat(t *testing.T) {
+	for _, f := range []string{"text", "json", "prometheus", "influx"} {
+		if got, err := ParseFormat(f); err != nil || string(got) != f {
+			t.Errorf("ParseFormat(%q) = (%q, %v), want (%q, nil)", f, got, err, f)
+		}
+	}
+
+	if _, err := ParseFormat("xml"); err == nil {
+		t.Error("ParseFormat(\"xml\") = nil error, want an error for an unknown format")
+	}
+}
+
+// TestEncodeRoundTrip checks that JSON, Prometheus and Influx all report the
+// same memory figures taken from one StatusBar, so a scraper switching
+// encodings doesn't see the numbers change underneath it.
+func TestEncodeRoundTrip(t *testing.T) {
+	sb := StatusBar{
+		mem:  &mem.VirtualMemoryStat{Total: 8000, Used: 2000, UsedPercent: 25},
+		disk: &disk.UsageStat{Path: "/", Total: 9000, Used: 3000, UsedPercent: 33.3},
+	}
+
+	var jsonBuf, promBuf, influxBuf bytes.Buffer
+	if err := sb.Encode(&jsonBuf, FormatJSON); err != nil {
+		t.Fatalf("Encode(FormatJSON): %v", err)
+	}
+	if err := sb.Encode(&promBuf, FormatPrometheus); err != nil {
+		t.Fatalf("Encode(FormatPrometheus): %v", err)
+	}
+	if err := sb.Encode(&influxBuf, FormatInflux); err != nil {
+		t.Fatalf("Encode(FormatInflux): %v", err)
+	}
+
+	if !strings.Contains(jsonBuf.String(), `"used_bytes":2000`) {
+		t.Errorf("JSON output = %s, want used_bytes=2000", jsonBuf.String())
+	}
+	if !strings.Contains(promBuf.String(), `peeker_mem_used_bytes{state="used"} 2000`) {
+		t.Errorf("Prometheus output = %s, want peeker_mem_used_bytes{state=\"used\"} 2000", promBuf.String())
+	}
+	if !strings.Contains(promBuf.String(), "peeker_mem_total_bytes 8000") {
+		t.Errorf("Prometheus output = %s, want peeker_mem_total_bytes 8000", promBuf.String())
+	}
+	if !strings.Contains(influxBuf.String(), "mem_used_bytes=2000i") {
+		t.Errorf("Influx output = %s, want mem_used_bytes=2000i", influxBuf.String())
+	}
+}
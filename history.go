@@ -0,0 +1,220 @@
+package main
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/shirou/gopsutil/v4/cpu"
+	"github.com/shirou/gopsutil/v4/disk"
+	"github.com/shirou/gopsutil/v4/mem"
+)
+
+// historySampleInterval is how often background samplers record a point.
+const historySampleInterval = time.Second
+
+// sparkTicks are the unicode block characters used to render a sparkline,
+// from lowest to highest.
+var sparkTicks = []rune("▁▂▃▄▅▆▇█")
+
+// history is a fixed-capacity ring buffer of recent samples for a metric.
+// add and values run on different goroutines (a background sampler and
+// whatever goroutine renders the status bar), so both are guarded by mu.
+type history struct {
+	mu      sync.Mutex
+	samples []float64
+	next    int
+	full    bool
+}
+
+// newHistory allocates a history able to hold capacity samples.
+func newHistory(capacity int) *history {
+	if capacity <= 0 {
+		capacity = 1
+	}
+	return &history{samples: make([]float64, capacity)}
+}
+
+// add records a new sample, overwriting the oldest once the buffer is full.
+func (h *history) add(v float64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.samples[h.next] = v
+	h.next = (h.next + 1) % len(h.samples)
+	if h.next == 0 {
+		h.full = true
+	}
+}
+
+// values returns the recorded samples in chronological order, oldest first.
+func (h *history) values() []float64 {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if !h.full {
+		return append([]float64(nil), h.samples[:h.next]...)
+	}
+	ordered := make([]float64, 0, len(h.samples))
+	ordered = append(ordered, h.samples[h.next:]...)
+	ordered = append(ordered, h.samples[:h.next]...)
+	return ordered
+}
+
+// samplesFor returns how many historySampleInterval-spaced points fit in
+// window.
+func samplesFor(window time.Duration) int {
+	n := int(window / historySampleInterval)
+	if n <= 0 {
+		n = 1
+	}
+	return n
+}
+
+// renderSparkline renders samples as a line of unicode block characters,
+// scaled to the samples' own min/max and clipped to the last width points.
+func renderSparkline(samples []float64, width int) string {
+	if width <= 0 || len(samples) == 0 {
+		return ""
+	}
+	if len(samples) > width {
+		samples = samples[len(samples)-width:]
+	}
+
+	min, max := samples[0], samples[0]
+	for _, v := range samples {
+		if v < min {
+			min = v
+		}
+		if v > max {
+			max = v
+		}
+	}
+	span := max - min
+
+	var b strings.Builder
+	for _, v := range samples {
+		idx := len(sparkTicks) - 1
+		if span > 0 {
+			idx = int((v - min) / span * float64(len(sparkTicks)-1))
+		}
+		b.WriteRune(sparkTicks[idx])
+	}
+	return b.String()
+}
+
+// WithCPUHistory starts a background sampler that keeps a ring buffer of
+// overall CPU usage percentages spanning window, for use with
+// renderSparkline.
+func (sb *StatusBar) WithCPUHistory(window time.Duration) *StatusBar {
+	sb.cpuHistory = newHistory(samplesFor(window))
+	go sb.sampleCPUHistory()
+	return sb
+}
+
+// WithMemoryHistory starts a background sampler that keeps a ring buffer of
+// memory usage percentages spanning window.
+func (sb *StatusBar) WithMemoryHistory(window time.Duration) *StatusBar {
+	sb.memHistory = newHistory(samplesFor(window))
+	go sb.sampleMemoryHistory()
+	return sb
+}
+
+// WithDiskIOHistory starts a background sampler that keeps a ring buffer of
+// combined disk read+write throughput (MB/s) spanning window.
+func (sb *StatusBar) WithDiskIOHistory(window time.Duration) *StatusBar {
+	sb.diskIOHistory = newHistory(samplesFor(window))
+	go sb.sampleDiskIOHistory()
+	return sb
+}
+
+func (sb *StatusBar) sampleCPUHistory() {
+	ticker := time.NewTicker(historySampleInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-sb.done():
+			return
+		case <-ticker.C:
+			percentages, err := cpu.Percent(0, false)
+			if err != nil || len(percentages) == 0 {
+				continue
+			}
+			sb.cpuHistory.add(percentages[0])
+		}
+	}
+}
+
+func (sb *StatusBar) sampleMemoryHistory() {
+	ticker := time.NewTicker(historySampleInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-sb.done():
+			return
+		case <-ticker.C:
+			vmStat, err := mem.VirtualMemory()
+			if err != nil {
+				continue
+			}
+			sb.memHistory.add(vmStat.UsedPercent)
+		}
+	}
+}
+
+func (sb *StatusBar) sampleDiskIOHistory() {
+	ticker := time.NewTicker(historySampleInterval)
+	defer ticker.Stop()
+
+	var lastTotal uint64
+	haveLast := false
+	for {
+		select {
+		case <-sb.done():
+			return
+		case <-ticker.C:
+			counters, err := disk.IOCounters()
+			if err != nil {
+				continue
+			}
+
+			var total uint64
+			for _, c := range counters {
+				total += c.ReadBytes + c.WriteBytes
+			}
+
+			if haveLast {
+				sb.diskIOHistory.add(float64(total-lastTotal) / float64(megabyteDiv))
+			}
+			lastTotal = total
+			haveLast = true
+		}
+	}
+}
+
+// DisplayCPUHistory renders the CPU usage sparkline, or an empty string if
+// WithCPUHistory was never called.
+func DisplayCPUHistory(sb StatusBar, width int) string {
+	if sb.cpuHistory == nil {
+		return ""
+	}
+	return generalTextStyle.Width(width).Render("CPU:  " + renderSparkline(sb.cpuHistory.values(), width))
+}
+
+// DisplayMemoryHistory renders the memory usage sparkline, or an empty
+// string if WithMemoryHistory was never called.
+func DisplayMemoryHistory(sb StatusBar, width int) string {
+	if sb.memHistory == nil {
+		return ""
+	}
+	return generalTextStyle.Width(width).Render("Mem:  " + renderSparkline(sb.memHistory.values(), width))
+}
+
+// DisplayDiskIOHistory renders the disk throughput sparkline, or an empty
+// string if WithDiskIOHistory was never called.
+func DisplayDiskIOHistory(sb StatusBar, width int) string {
+	if sb.diskIOHistory == nil {
+		return ""
+	}
+	return generalTextStyle.Width(width).Render("Disk: " + renderSparkline(sb.diskIOHistory.values(), width))
+}
@@ -0,0 +1,25 @@
+package main
+
+import "testing"
+
+func TestRenderSparkline(t *testing.T) {
+	if got := renderSparkline(nil, 10); got != "" {
+		t.Errorf("renderSparkline(nil, 10) = %q, want empty", got)
+	}
+	if got := renderSparkline([]float64{1, 2, 3}, 0); got != "" {
+		t.Errorf("renderSparkline(samples, 0) = %q, want empty", got)
+	}
+
+	got := renderSparkline([]float64{0, 100}, 10)
+	want := string([]rune{sparkTicks[0], sparkTicks[len(sparkTicks)-1]})
+	if got != want {
+		t.Errorf("renderSparkline([0,100], 10) = %q, want min/max scaled to the first/last tick (%q)", got, want)
+	}
+
+	// More samples than width: only the trailing `width` samples are used.
+	got = renderSparkline([]float64{100, 0, 100}, 1)
+	want = string(sparkTicks[len(sparkTicks)-1])
+	if got != want {
+		t.Errorf("renderSparkline with width=1 = %q, want only the last sample rendered (%q)", got, want)
+	}
+}
@@ -1,15 +1,21 @@
 package main
 
 import (
+	"context"
+	"flag"
 	"fmt"
 	"os"
 	"strconv"
+	"sync"
+	"time"
 
+	"github.com/Morfo-si/peeker/devices"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/shirou/gopsutil/v4/cpu"
 	"github.com/shirou/gopsutil/v4/disk"
 	"github.com/shirou/gopsutil/v4/host"
 	"github.com/shirou/gopsutil/v4/mem"
+	"github.com/shirou/gopsutil/v4/sensors"
 
 	"golang.org/x/term"
 	"golang.org/x/text/cases"
@@ -68,14 +74,13 @@ var (
 func DisplayHostMemory(sb StatusBar, width int) string {
 	// Memory information
 	var (
-		memoryTotal, memoryAvailable, memoryInUse uint64
-		memoryUsedPercentenge                     float64
+		memoryTotal, memoryInUse uint64
+		memoryUsedPercentenge    float64
 	)
 
 	if sb.mem != nil {
 		memoryTotal = sb.mem.Total / megabyteDiv
-		memoryAvailable = sb.mem.Available / megabyteDiv
-		memoryInUse = memoryTotal - memoryAvailable
+		memoryInUse = sb.mem.Used / megabyteDiv
 		memoryUsedPercentenge = sb.mem.UsedPercent
 	}
 	memoryInformation := fmt.Sprintf("Memory: %d of %d MB used (%2.f%%)", memoryInUse, memoryTotal, memoryUsedPercentenge)
@@ -87,7 +92,7 @@ func DisplayHostMemory(sb StatusBar, width int) string {
 
 // GetHostMemory fetches information about the host's memory.
 func GetHostMemory() (*mem.VirtualMemoryStat, error) {
-	vmStat, err := mem.VirtualMemory()
+	vmStat, err := devices.Mem.VirtualMemory()
 	if err != nil {
 		return nil, err
 	}
@@ -128,13 +133,21 @@ func DisplayPlatformInformation(sb StatusBar) string {
 
 // GetHostInformation fetches information for the host.
 func GetHostInformation() (*host.InfoStat, error) {
-	hostStat, err := host.Info()
+	hostStat, err := devices.Host.Info()
 	if err != nil {
 		return nil, err
 	}
 	return hostStat, nil
 }
 
+// GetHostTemperatures fetches sensor temperatures for the host.
+func GetHostTemperatures() ([]sensors.TemperatureStat, error) {
+	if devices.Temp == nil {
+		return nil, nil
+	}
+	return devices.Temp.Temperatures()
+}
+
 // DisplayDiskInformation displays disk information on the console
 func DisplayDiskInformation(sb StatusBar) string {
 	// Disk information
@@ -154,7 +167,7 @@ func DisplayDiskInformation(sb StatusBar) string {
 
 // GetDiskInformation returns the file system usage.
 func GetDiskInformation() (*disk.UsageStat, error) {
-	diskStat, err := disk.Usage("/")
+	diskStat, err := devices.Disk.Usage("/")
 	if err != nil {
 		return nil, err
 	}
@@ -179,7 +192,7 @@ func DisplayCPUPercentage(percentage []float64) {
 
 // GetCPUPercentage calculates the percentage of cpu used either per CPU or combined.
 func GetCPUPercentage() ([]float64, error) {
-	percentage, err := cpu.Percent(0, true)
+	percentage, err := devices.CPU.Percent()
 	if err != nil {
 		return nil, err
 	}
@@ -197,9 +210,25 @@ func DisplayCPUInformation(sb StatusBar) string {
 	return highlightRightStyle.Render(cpuInformation)
 }
 
+// DisplayTemperature displays the average sensor temperature, if any sensors
+// were found.
+func DisplayTemperature(sb StatusBar) string {
+	if len(sb.temp) == 0 {
+		return ""
+	}
+
+	var total float64
+	for _, t := range sb.temp {
+		total += t.Temperature
+	}
+	average := total / float64(len(sb.temp))
+
+	return generalTextStyle.Render(fmt.Sprintf("Temp: %.1f°C", average))
+}
+
 // GetCPUStat returns only one CPUInfoStat on FreeBSD
 func GetCPUStat() ([]cpu.InfoStat, error) {
-	cpuStat, err := cpu.Info()
+	cpuStat, err := devices.CPU.Info()
 	if err != nil {
 		return nil, err
 	}
@@ -209,15 +238,57 @@ func GetCPUStat() ([]cpu.InfoStat, error) {
 
 // Struct used to represent a StatusBar
 type StatusBar struct {
-	cpu  []cpu.InfoStat
-	disk *disk.UsageStat
-	host *host.InfoStat
-	mem  *mem.VirtualMemoryStat
+	cpu        []cpu.InfoStat
+	cpuPercent float64
+	disk       *disk.UsageStat
+	disks      []*disk.UsageStat
+	host       *host.InfoStat
+	mem        *mem.VirtualMemoryStat
+	temp       []sensors.TemperatureStat
+	netRates   *netRatesStore
+
+	// Ring buffers backing the optional sparkline widgets. Populated by
+	// WithCPUHistory, WithMemoryHistory and WithDiskIOHistory respectively.
+	cpuHistory    *history
+	memHistory    *history
+	diskIOHistory *history
+
+	// life is held by pointer (rather than embedding sync.Once/a channel
+	// directly) so that StatusBar itself stays safe to copy by value, which
+	// the Display* helpers and Snapshot rely on throughout this package.
+	life *statusBarLifecycle
+}
+
+// statusBarLifecycle backs StatusBar.Close, letting every copy of a
+// StatusBar share the same shutdown signal for its background samplers.
+type statusBarLifecycle struct {
+	closeOnce sync.Once
+	closed    chan struct{}
 }
 
 // New StatusBar with no features.
 func NewStatusBar() *StatusBar {
-	return &StatusBar{}
+	return &StatusBar{life: &statusBarLifecycle{closed: make(chan struct{})}}
+}
+
+// done returns a channel that's closed once Close is called, used by
+// background samplers (history and network) to stop.
+func (sb *StatusBar) done() <-chan struct{} {
+	if sb.life == nil {
+		return nil
+	}
+	return sb.life.closed
+}
+
+// Close stops any background samplers started by WithCPUHistory,
+// WithMemoryHistory, WithDiskIOHistory or WithNetworkInformation. It is
+// safe to call more than once, and safe to call even if no sampler was
+// ever started.
+func (sb *StatusBar) Close() {
+	if sb.life == nil {
+		return
+	}
+	sb.life.closeOnce.Do(func() { close(sb.life.closed) })
 }
 
 // New StatusBar with feature.
@@ -233,6 +304,9 @@ func (sb *StatusBar) WithCPUInformation() *StatusBar {
 	if cpu, err := GetCPUStat(); err == nil {
 		sb.cpu = cpu
 	}
+	if percentage, err := GetCPUPercentage(); err == nil {
+		sb.cpuPercent = average(percentage)
+	}
 	return sb
 }
 
@@ -252,8 +326,18 @@ func (sb *StatusBar) WithDiskInformation() *StatusBar {
 	return sb
 }
 
-// Renders the StatusBar with all features.
-func (sb StatusBar) Render() {
+// New StatusBar with Temperature feature. Sensor availability depends on
+// the host OS; see the devices package.
+func (sb *StatusBar) WithTemperature() *StatusBar {
+	if temp, err := GetHostTemperatures(); err == nil {
+		sb.temp = temp
+	}
+	return sb
+}
+
+// layout builds the status bar as a single string, shared by the one-shot
+// Render and the interactive Run mode.
+func (sb StatusBar) layout() string {
 	// Shortcut to get accurate width from a given string.
 	w := lipgloss.Width
 
@@ -267,6 +351,8 @@ func (sb StatusBar) Render() {
 	diskCell := DisplayDiskInformation(sb)
 	// Memory information
 	memoryCell := DisplayHostMemory(sb, terminalWidth-w(diskCell))
+	// Temperature information
+	tempCell := DisplayTemperature(sb)
 
 	// Top line for status bar.
 	firstLine := lipgloss.JoinHorizontal(lipgloss.Top,
@@ -279,20 +365,84 @@ func (sb StatusBar) Render() {
 		memoryCell,
 		diskCell,
 	)
+	if tempCell != "" {
+		secondLine = lipgloss.JoinHorizontal(lipgloss.Top, secondLine, tempCell)
+	}
 
-	bar := lipgloss.JoinVertical(lipgloss.Top,
-		firstLine, secondLine,
-	)
-	fmt.Println(bar)
+	// Sparkline history rows, rendered above the summary lines, one per
+	// metric that has a history builder enabled.
+	historyWidth := terminalWidth - w("CPU:  ")
+	var lines []string
+	for _, cell := range []string{
+		DisplayCPUHistory(sb, historyWidth),
+		DisplayMemoryHistory(sb, historyWidth),
+		DisplayDiskIOHistory(sb, historyWidth),
+	} {
+		if cell != "" {
+			lines = append(lines, cell)
+		}
+	}
+	lines = append(lines, firstLine, secondLine)
+
+	// Optional per-partition disk and per-interface network rows.
+	for _, cell := range []string{
+		DisplayAllDisks(sb, terminalWidth),
+		DisplayNetworkInformation(sb, terminalWidth),
+	} {
+		if cell != "" {
+			lines = append(lines, cell)
+		}
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Top, lines...)
+}
 
+// Renders the StatusBar with all features.
+func (sb StatusBar) Render() {
+	_ = sb.Encode(os.Stdout, FormatText)
 }
 
 func main() {
+	interactive := flag.Bool("i", false, "run peeker in interactive mode, redrawing the status bar in place")
+	interval := flag.Duration("interval", time.Second, "refresh interval for interactive mode")
+	format := flag.String("format", "text", "output format: text, json, prometheus or influx")
+	serve := flag.String("serve", "", "serve Prometheus metrics over HTTP at the given address (e.g. :9100) instead of printing once")
+	flag.Parse()
+
+	if *serve != "" {
+		if err := Serve(*serve); err != nil {
+			fmt.Fprintln(os.Stderr, "peeker:", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	outputFormat, err := ParseFormat(*format)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "peeker:", err)
+		os.Exit(1)
+	}
+
 	// Display status bar with system information.
 	bar := NewStatusBar().
 		WithHostInformation().
 		WithCPUInformation().
 		WithMemoryInformation().
-		WithDiskInformation()
-	bar.Render()
+		WithDiskInformation().
+		WithTemperature().
+		WithAllDisks().
+		WithNetworkInformation()
+
+	if *interactive {
+		if err := bar.Run(context.Background(), RunOptions{Interval: *interval}); err != nil {
+			fmt.Fprintln(os.Stderr, "peeker:", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if err := bar.Encode(os.Stdout, outputFormat); err != nil {
+		fmt.Fprintln(os.Stderr, "peeker:", err)
+		os.Exit(1)
+	}
 }
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Morfo-si/peeker/devices"
+	"github.com/shirou/gopsutil/v4/cpu"
+	"github.com/shirou/gopsutil/v4/disk"
+	"github.com/shirou/gopsutil/v4/host"
+	"github.com/shirou/gopsutil/v4/mem"
+)
+
+// fakeCPU, fakeMem, fakeDisk and fakeHost are the fakes the devices package
+// split was meant to enable: swap the package-level providers so With*
+// builders can be tested without touching the real host.
+
+type fakeCPU struct {
+	info    []cpu.InfoStat
+	percent []float64
+}
+
+func (f fakeCPU) Info() ([]cpu.InfoStat, error) { return f.info, nil }
+func (f fakeCPU) Percent() ([]float64, error)   { return f.percent, nil }
+
+type fakeMem struct{ stat *mem.VirtualMemoryStat }
+
+func (f fakeMem) VirtualMemory() (*mem.VirtualMemoryStat, error) { return f.stat, nil }
+
+type fakeDisk struct{ stat *disk.UsageStat }
+
+func (f fakeDisk) Usage(path string) (*disk.UsageStat, error) { return f.stat, nil }
+
+type fakeHost struct{ stat *host.InfoStat }
+
+func (f fakeHost) Info() (*host.InfoStat, error) { return f.stat, nil }
+
+func TestWithInformationBuildersUseFakeProviders(t *testing.T) {
+	origCPU, origMem, origDisk, origHost := devices.CPU, devices.Mem, devices.Disk, devices.Host
+	t.Cleanup(func() {
+		devices.CPU, devices.Mem, devices.Disk, devices.Host = origCPU, origMem, origDisk, origHost
+	})
+
+	devices.CPU = fakeCPU{
+		info:    []cpu.InfoStat{{ModelName: "Fake CPU", Mhz: 1234}},
+		percent: []float64{10, 30},
+	}
+	devices.Mem = fakeMem{stat: &mem.VirtualMemoryStat{Total: 1000, Used: 400, UsedPercent: 40}}
+	devices.Disk = fakeDisk{stat: &disk.UsageStat{Path: "/", Total: 2000, Used: 500, UsedPercent: 25}}
+	devices.Host = fakeHost{stat: &host.InfoStat{Hostname: "fakehost", KernelArch: "fakearch"}}
+
+	bar := NewStatusBar().
+		WithCPUInformation().
+		WithMemoryInformation().
+		WithDiskInformation().
+		WithHostInformation()
+
+	if bar.cpuPercent != 20 {
+		t.Errorf("cpuPercent = %v, want 20 (average of 10 and 30)", bar.cpuPercent)
+	}
+	if len(bar.cpu) != 1 || bar.cpu[0].ModelName != "Fake CPU" {
+		t.Errorf("cpu = %+v, want fake CPU info", bar.cpu)
+	}
+	if bar.mem == nil || bar.mem.Used != 400 {
+		t.Errorf("mem = %+v, want Used=400", bar.mem)
+	}
+	if bar.disk == nil || bar.disk.Used != 500 {
+		t.Errorf("disk = %+v, want Used=500", bar.disk)
+	}
+	if bar.host == nil || bar.host.Hostname != "fakehost" {
+		t.Errorf("host = %+v, want Hostname=fakehost", bar.host)
+	}
+}
+
+func TestDisplayHostMemoryMatchesMemUsed(t *testing.T) {
+	sb := StatusBar{mem: &mem.VirtualMemoryStat{
+		Total:       8 * megabyteDiv,
+		Used:        2 * megabyteDiv,
+		Available:   5 * megabyteDiv,
+		UsedPercent: 25,
+	}}
+
+	got := DisplayHostMemory(sb, 80)
+	if !strings.Contains(got, "2 of 8 MB used") || !strings.Contains(got, "25%") {
+		t.Errorf("DisplayHostMemory = %q, want it to report mem.Used (2 MB), not Total-Available (3 MB)", got)
+	}
+}
@@ -0,0 +1,221 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/gen2brain/beeep"
+)
+
+// AlertFlags is a bitmask of the metrics that crossed their configured
+// threshold during a Monitor sampling pass.
+type AlertFlags uint8
+
+const (
+	AlertCPU  AlertFlags = 1 << iota // CPU=1
+	AlertDisk                        // Disk=2
+	AlertMem                         // Mem=4
+	AlertTemp                        // Temp=8
+)
+
+// String renders the set alert bits as a comma-separated list, e.g.
+// "cpu,mem".
+func (f AlertFlags) String() string {
+	var parts []string
+	if f&AlertCPU != 0 {
+		parts = append(parts, "cpu")
+	}
+	if f&AlertDisk != 0 {
+		parts = append(parts, "disk")
+	}
+	if f&AlertMem != 0 {
+		parts = append(parts, "mem")
+	}
+	if f&AlertTemp != 0 {
+		parts = append(parts, "temp")
+	}
+	if len(parts) == 0 {
+		return "none"
+	}
+	return strings.Join(parts, ",")
+}
+
+// MonitorConfig configures a Monitor's sampling loop and alert thresholds.
+// A zero threshold disables alerting for that metric.
+type MonitorConfig struct {
+	Interval time.Duration
+	CPUPct   int
+	MemPct   int
+	DiskPct  int
+	TempC    float64
+}
+
+// Snapshot is a single sampling pass taken by Monitor.Run.
+type Snapshot struct {
+	StatusBar
+	CPUPercent float64
+	TempC      float64
+}
+
+// Monitor runs StatusBar sampling in a loop and reports threshold crossings
+// to a caller-supplied callback, turning peeker into a lightweight
+// always-on watchdog. It's exposed as a library API only for now; there is
+// no CLI flag that runs it, so wiring one of StderrNotifier, WebhookNotifier
+// or DesktopNotifier up to the binary is left to a future change.
+type Monitor struct {
+	Config MonitorConfig
+}
+
+// NewMonitor creates a Monitor with the given configuration.
+func NewMonitor(cfg MonitorConfig) *Monitor {
+	return &Monitor{Config: cfg}
+}
+
+// Run samples the host on Config.Interval until ctx is canceled, invoking
+// onSnapshot with every sample and the flags that crossed their threshold.
+func (m *Monitor) Run(ctx context.Context, onSnapshot func(snapshot Snapshot, flags AlertFlags)) error {
+	interval := m.Config.Interval
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			snap, flags := m.sample()
+			onSnapshot(snap, flags)
+		}
+	}
+}
+
+// sample takes one reading and computes which thresholds it crosses.
+func (m *Monitor) sample() (Snapshot, AlertFlags) {
+	bar := NewStatusBar().
+		WithHostInformation().
+		WithCPUInformation().
+		WithMemoryInformation().
+		WithDiskInformation().
+		WithTemperature()
+
+	snap := Snapshot{StatusBar: *bar}
+	snap.CPUPercent = bar.cpuPercent
+	if len(bar.temp) > 0 {
+		var total float64
+		for _, t := range bar.temp {
+			total += t.Temperature
+		}
+		snap.TempC = total / float64(len(bar.temp))
+	}
+
+	var flags AlertFlags
+	if m.Config.CPUPct > 0 && snap.CPUPercent >= float64(m.Config.CPUPct) {
+		flags |= AlertCPU
+	}
+	if bar.mem != nil && m.Config.MemPct > 0 && bar.mem.UsedPercent >= float64(m.Config.MemPct) {
+		flags |= AlertMem
+	}
+	if bar.disk != nil && m.Config.DiskPct > 0 && bar.disk.UsedPercent >= float64(m.Config.DiskPct) {
+		flags |= AlertDisk
+	}
+	if len(bar.temp) > 0 && m.Config.TempC > 0 && snap.TempC >= m.Config.TempC {
+		flags |= AlertTemp
+	}
+
+	return snap, flags
+}
+
+func average(vs []float64) float64 {
+	if len(vs) == 0 {
+		return 0
+	}
+	var total float64
+	for _, v := range vs {
+		total += v
+	}
+	return total / float64(len(vs))
+}
+
+// StderrNotifier returns a notifier that prints alerts to stderr.
+func StderrNotifier() func(Snapshot, AlertFlags) {
+	return func(snap Snapshot, flags AlertFlags) {
+		if flags == 0 {
+			return
+		}
+		fmt.Fprintf(os.Stderr, "peeker: alert (%s): cpu=%.0f%% mem=%.0f%% disk=%.0f%% temp=%.1f°C\n",
+			flags, snap.CPUPercent, memPercent(snap), diskPercent(snap), snap.TempC)
+	}
+}
+
+// webhookPayload is the JSON body POSTed by WebhookNotifier.
+type webhookPayload struct {
+	Flags       string  `json:"flags"`
+	CPUPercent  float64 `json:"cpu_percent"`
+	MemPercent  float64 `json:"mem_percent"`
+	DiskPercent float64 `json:"disk_percent"`
+	TempC       float64 `json:"temp_c"`
+}
+
+// WebhookNotifier returns a notifier that POSTs a JSON payload to url
+// whenever an alert fires.
+func WebhookNotifier(url string) func(Snapshot, AlertFlags) {
+	client := &http.Client{Timeout: 5 * time.Second}
+
+	return func(snap Snapshot, flags AlertFlags) {
+		if flags == 0 {
+			return
+		}
+
+		body, err := json.Marshal(webhookPayload{
+			Flags:       flags.String(),
+			CPUPercent:  snap.CPUPercent,
+			MemPercent:  memPercent(snap),
+			DiskPercent: diskPercent(snap),
+			TempC:       snap.TempC,
+		})
+		if err != nil {
+			return
+		}
+
+		resp, err := client.Post(url, "application/json", bytes.NewReader(body))
+		if err != nil {
+			return
+		}
+		resp.Body.Close()
+	}
+}
+
+// DesktopNotifier returns a notifier that raises a desktop notification via
+// beeep whenever an alert fires.
+func DesktopNotifier(title string) func(Snapshot, AlertFlags) {
+	return func(snap Snapshot, flags AlertFlags) {
+		if flags == 0 {
+			return
+		}
+		_ = beeep.Notify(title, fmt.Sprintf("threshold crossed: %s", flags), "")
+	}
+}
+
+func memPercent(snap Snapshot) float64 {
+	if snap.mem == nil {
+		return 0
+	}
+	return snap.mem.UsedPercent
+}
+
+func diskPercent(snap Snapshot) float64 {
+	if snap.disk == nil {
+		return 0
+	}
+	return snap.disk.UsedPercent
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/Morfo-si/peeker/devices"
+	"github.com/shirou/gopsutil/v4/sensors"
+)
+
+type fakeTempProvider struct{ temps []float64 }
+
+func (f fakeTempProvider) Temperatures() ([]sensors.TemperatureStat, error) {
+	stats := make([]sensors.TemperatureStat, len(f.temps))
+	for i, t := range f.temps {
+		stats[i] = sensors.TemperatureStat{SensorKey: "fake", Temperature: t}
+	}
+	return stats, nil
+}
+
+func TestAlertFlagsString(t *testing.T) {
+	cases := []struct {
+		flags AlertFlags
+		want  string
+	}{
+		{0, "none"},
+		{AlertCPU, "cpu"},
+		{AlertCPU | AlertMem, "cpu,mem"},
+		{AlertCPU | AlertDisk | AlertMem | AlertTemp, "cpu,disk,mem,temp"},
+	}
+
+	for _, c := range cases {
+		if got := c.flags.String(); got != c.want {
+			t.Errorf("AlertFlags(%d).String() = %q, want %q", c.flags, got, c.want)
+		}
+	}
+}
+
+func TestMonitorSampleTempThreshold(t *testing.T) {
+	origTemp := devices.Temp
+	t.Cleanup(func() { devices.Temp = origTemp })
+
+	devices.Temp = fakeTempProvider{temps: []float64{40, 60}}
+
+	m := NewMonitor(MonitorConfig{TempC: 45})
+	snap, flags := m.sample()
+
+	if snap.TempC != 50 {
+		t.Fatalf("snap.TempC = %v, want 50 (average of 40 and 60)", snap.TempC)
+	}
+	if flags&AlertTemp == 0 {
+		t.Errorf("flags = %s, want AlertTemp set since 50 >= 45", flags)
+	}
+
+	m.Config.TempC = 55
+	_, flags = m.sample()
+	if flags&AlertTemp != 0 {
+		t.Errorf("flags = %s, want AlertTemp unset since 50 < 55", flags)
+	}
+}
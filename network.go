@@ -0,0 +1,124 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/shirou/gopsutil/v4/net"
+)
+
+// netRate is the per-interface throughput computed between two samples.
+type netRate struct {
+	sentKBps float64
+	recvKBps float64
+}
+
+// netRatesStore holds the latest per-interface rates behind a mutex: the
+// background sampler replaces the whole map on every tick while the render
+// path reads a snapshot of it, on different goroutines.
+type netRatesStore struct {
+	mu    sync.Mutex
+	rates map[string]netRate
+}
+
+func (s *netRatesStore) set(rates map[string]netRate) {
+	s.mu.Lock()
+	s.rates = rates
+	s.mu.Unlock()
+}
+
+func (s *netRatesStore) snapshot() map[string]netRate {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.rates
+}
+
+// WithNetworkInformation starts a background sampler that tracks bytes
+// sent/received deltas per interface, for use by DisplayNetworkInformation.
+func (sb *StatusBar) WithNetworkInformation() *StatusBar {
+	sb.netRates = &netRatesStore{}
+	go sb.sampleNetwork()
+	return sb
+}
+
+func (sb *StatusBar) sampleNetwork() {
+	ticker := time.NewTicker(historySampleInterval)
+	defer ticker.Stop()
+
+	last := map[string]net.IOCountersStat{}
+	haveLast := false
+
+	for {
+		select {
+		case <-sb.done():
+			return
+		case <-ticker.C:
+			counters, err := net.IOCounters(true)
+			if err != nil {
+				continue
+			}
+
+			if haveLast {
+				elapsed := historySampleInterval.Seconds()
+				rates := make(map[string]netRate, len(counters))
+				for _, c := range counters {
+					prev, ok := last[c.Name]
+					if !ok {
+						continue
+					}
+					rates[c.Name] = netRate{
+						sentKBps: float64(c.BytesSent-prev.BytesSent) / 1024 / elapsed,
+						recvKBps: float64(c.BytesRecv-prev.BytesRecv) / 1024 / elapsed,
+					}
+				}
+				sb.netRates.set(rates)
+			}
+
+			last = make(map[string]net.IOCountersStat, len(counters))
+			for _, c := range counters {
+				last[c.Name] = c
+			}
+			haveLast = true
+		}
+	}
+}
+
+// DisplayNetworkInformation renders per-interface throughput, or an empty
+// string if WithNetworkInformation was never called. When the terminal is
+// too narrow to fit a column per interface, it collapses to a single
+// combined throughput line.
+func DisplayNetworkInformation(sb StatusBar, width int) string {
+	if sb.netRates == nil {
+		return ""
+	}
+	rates := sb.netRates.snapshot()
+	if len(rates) == 0 {
+		return ""
+	}
+
+	names := make([]string, 0, len(rates))
+	for name := range rates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var totalSent, totalRecv float64
+	rows := make([]string, 0, len(names))
+	for _, name := range names {
+		rate := rates[name]
+		totalSent += rate.sentKBps
+		totalRecv += rate.recvKBps
+		rows = append(rows, fmt.Sprintf("%s ↑%.0f↓%.0f KB/s", name, rate.sentKBps, rate.recvKBps))
+	}
+
+	line := strings.Join(rows, "  ")
+	if lipgloss.Width(line) > width {
+		line = fmt.Sprintf("Net: ↑%.0f↓%.0f KB/s", totalSent, totalRecv)
+	}
+
+	return generalTextStyle.Width(width).Align(lipgloss.Left).Render(line)
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// RunOptions configures the interactive TUI mode started by StatusBar.Run.
+type RunOptions struct {
+	// Interval controls how often CPU/mem/disk/host information is re-sampled.
+	Interval time.Duration
+}
+
+// DefaultRunOptions returns the options used when Run is called with a zero
+// Interval.
+func DefaultRunOptions() RunOptions {
+	return RunOptions{Interval: time.Second}
+}
+
+// tickMsg signals that it's time to re-sample system information.
+type tickMsg time.Time
+
+// statusBarModel is the bubbletea model driving the interactive status bar.
+type statusBarModel struct {
+	sb     *StatusBar
+	opts   RunOptions
+	paused bool
+}
+
+func newStatusBarModel(sb *StatusBar, opts RunOptions) statusBarModel {
+	return statusBarModel{sb: sb, opts: opts}
+}
+
+func tick(d time.Duration) tea.Cmd {
+	return tea.Tick(d, func(t time.Time) tea.Msg {
+		return tickMsg(t)
+	})
+}
+
+// Init starts the refresh ticker.
+func (m statusBarModel) Init() tea.Cmd {
+	return tick(m.opts.Interval)
+}
+
+// Update handles keybindings (q: quit, p: pause/resume, r: refresh now) and
+// the refresh ticker.
+func (m statusBarModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "q", "ctrl+c":
+			return m, tea.Quit
+		case "p":
+			m.paused = !m.paused
+			return m, nil
+		case "r":
+			m.sample()
+			return m, nil
+		}
+	case tickMsg:
+		if !m.paused {
+			m.sample()
+		}
+		return m, tick(m.opts.Interval)
+	}
+	return m, nil
+}
+
+// sample re-populates the underlying StatusBar with fresh readings.
+func (m *statusBarModel) sample() {
+	m.sb.WithHostInformation().
+		WithCPUInformation().
+		WithMemoryInformation().
+		WithDiskInformation().
+		WithTemperature().
+		WithAllDisks()
+}
+
+// View renders the status bar in place, reusing StatusBar's own layout code.
+func (m statusBarModel) View() string {
+	return m.sb.layout()
+}
+
+// Run starts peeker in interactive mode: it re-samples on opts.Interval and
+// redraws the status bar in place until the user quits (q / ctrl+c) or ctx
+// is canceled. Any background samplers started on sb by WithCPUHistory,
+// WithMemoryHistory, WithDiskIOHistory or WithNetworkInformation are
+// stopped once Run returns.
+func (sb *StatusBar) Run(ctx context.Context, opts RunOptions) error {
+	defer sb.Close()
+
+	if opts.Interval <= 0 {
+		opts = DefaultRunOptions()
+	}
+
+	p := tea.NewProgram(newStatusBarModel(sb, opts), tea.WithContext(ctx))
+	_, err := p.Run()
+	return err
+}